@@ -2,6 +2,7 @@ package v1
 
 import (
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	operatorv1 "github.com/openshift/api/operator/v1"
@@ -77,8 +78,16 @@ type ImageRegistrySpec struct {
 	ReadOnly bool `json:"readOnly,omitempty"`
 	// disableRedirect controls whether to route all data through the Registry,
 	// rather than redirecting to the backend.
+	// Deprecated: use redirectPolicy instead. If both are set, redirectPolicy
+	// takes precedence; this field is translated into an equivalent
+	// redirectPolicy so existing configurations keep working.
 	// +optional
 	DisableRedirect bool `json:"disableRedirect,omitempty"`
+	// redirectPolicy configures, per HTTP verb and per storage driver,
+	// whether blob-serving requests are redirected to the storage backend or
+	// routed through the registry.
+	// +optional
+	RedirectPolicy *ImageRegistryConfigRedirect `json:"redirectPolicy,omitempty"`
 	// requests controls how many parallel requests a given registry instance
 	// will handle before queuing additional requests.
 	// +optional
@@ -124,6 +133,10 @@ type ImageRegistrySpec struct {
 	// +optional
 	// +listType=atomic
 	TopologySpreadConstraints []corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+	// pullThroughCache configures the registry to act as a pull-through
+	// cache of one or more upstream registries.
+	// +optional
+	PullThroughCache *ImageRegistryPullThroughCache `json:"pullThroughCache,omitempty"`
 }
 
 // ImageRegistryStatus reports image registry operational status.
@@ -135,6 +148,33 @@ type ImageRegistryStatus struct {
 	// storage indicates the current applied storage configuration of the
 	// registry.
 	Storage ImageRegistryConfigStorage `json:"storage"`
+	// credentialsLastRotated is the time the objectStore storage backend's
+	// credentials were last rotated, if objectStore is in use.
+	// +optional
+	CredentialsLastRotated metav1.Time `json:"credentialsLastRotated,omitempty"`
+	// storageRoutes reports, for each configured storage route, whether it
+	// is currently active.
+	// +optional
+	// +listType=map
+	// +listMapKey=repositoryPattern
+	StorageRoutes []ImageRegistryConfigStorageRouteStatus `json:"storageRoutes,omitempty"`
+}
+
+// ImageRegistryConfigStorageRouteStatus reports the applied state of a
+// single ImageRegistryConfigStorageRoute.
+type ImageRegistryConfigStorageRouteStatus struct {
+	// repositoryPattern identifies the route this status is for, matching
+	// spec.storage.routes[].repositoryPattern.
+	RepositoryPattern string `json:"repositoryPattern"`
+	// backendName is the backend this route currently resolves to.
+	BackendName string `json:"backendName"`
+	// active indicates whether the route's backend is configured and
+	// reachable.
+	Active bool `json:"active"`
+	// message provides human-readable detail, e.g. why a route is not
+	// active.
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 // ImageRegistryConfigProxy defines proxy configuration to be used by registry.
@@ -153,6 +193,51 @@ type ImageRegistryConfigProxy struct {
 	NoProxy string `json:"noProxy,omitempty"`
 }
 
+// MirrorRule maps a repository prefix to an upstream registry that the
+// pull-through cache proxies requests to.
+type MirrorRule struct {
+	// repositoryPrefix is the repository prefix this rule applies to, e.g.
+	// "docker.io/library/".
+	RepositoryPrefix string `json:"repositoryPrefix"`
+	// remoteURL is the SCHEME://HOST[/PATH] of the upstream registry to
+	// proxy repositoryPrefix to.
+	RemoteURL string `json:"remoteURL"`
+	// trustedCA is a reference to a config map containing a CA bundle. The
+	// image registry and its operator use certificates from this bundle to
+	// verify the upstream registry's server certificate.
+	//
+	// The namespace for the config map referenced by trustedCA is
+	// "openshift-config". The key for the bundle in the config map is
+	// "ca-bundle.crt".
+	// +optional
+	TrustedCA ConfigMapReference `json:"trustedCA"`
+}
+
+// ImageRegistryPullThroughCache configures the registry to act as a
+// proxying cache of one or more upstream registries, equivalent to
+// distribution's proxy configuration.
+// https://docs.docker.com/registry/configuration/#proxy
+type ImageRegistryPullThroughCache struct {
+	// remoteURL is the SCHEME://HOST[/PATH] of the default upstream
+	// registry to proxy.
+	RemoteURL string `json:"remoteURL"`
+	// upstreamCredentialsSecret references a Secret in the
+	// "openshift-image-registry" namespace containing username/password or
+	// bearer credentials for the default upstream registry.
+	// +optional
+	UpstreamCredentialsSecret corev1.LocalObjectReference `json:"upstreamCredentialsSecret,omitempty"`
+	// ttl is how long a cached blob or manifest is served before being
+	// revalidated against the upstream registry.
+	// +optional
+	// +kubebuilder:validation:Format=duration
+	TTL metav1.Duration `json:"ttl,omitempty"`
+	// mirrors maps repository prefixes to upstream registries other than
+	// remoteURL.
+	// +optional
+	// +listType=atomic
+	Mirrors []MirrorRule `json:"mirrors,omitempty"`
+}
+
 // ImageRegistryConfigStorageS3CloudFront holds the configuration
 // to use Amazon Cloudfront as the storage middleware in a registry.
 // https://docs.docker.com/registry/configuration/#cloudfront
@@ -209,13 +294,19 @@ type ImageRegistryConfigStorageS3 struct {
 	RegionEndpoint string `json:"regionEndpoint,omitempty"`
 	// encrypt specifies whether the registry stores the image in encrypted
 	// format or not.
+	// Deprecated: use encryption instead.
 	// Optional, defaults to false.
 	// +optional
 	Encrypt bool `json:"encrypt,omitempty"`
 	// keyID is the KMS key ID to use for encryption.
+	// Deprecated: use encryption instead.
 	// Optional, Encrypt must be true, or this parameter is ignored.
 	// +optional
 	KeyID string `json:"keyID,omitempty"`
+	// encryption configures server-side encryption of objects written to
+	// the bucket, including customer-managed KMS keys.
+	// +optional
+	Encryption *ImageRegistryEncryption `json:"encryption,omitempty"`
 	// cloudFront configures Amazon Cloudfront as the storage middleware in a
 	// registry.
 	// +optional
@@ -235,6 +326,14 @@ type ImageRegistryConfigStorageS3 struct {
 	// "ca-bundle.crt".
 	// +optional
 	TrustedCA S3TrustedCASource `json:"trustedCA"`
+	// chunkSizeMiB is the size, in mebibytes, of the multipart upload chunks
+	// the registry sends to S3. Larger chunks reduce the number of requests
+	// needed to upload large layers on high-bandwidth links.
+	// Optional, defaults to the driver's default of 5.
+	// +kubebuilder:validation:Minimum=5
+	// +kubebuilder:validation:Maximum=5120
+	// +optional
+	ChunkSizeMiB int32 `json:"chunkSizeMiB,omitempty"`
 }
 
 // ImageRegistryConfigStorageGCS holds GCS configuration.
@@ -253,10 +352,23 @@ type ImageRegistryConfigStorageGCS struct {
 	// +optional
 	ProjectID string `json:"projectID,omitempty"`
 	// keyID is the KMS key ID to use for encryption.
+	// Deprecated: use encryption instead.
 	// Optional, buckets are encrypted by default on GCP.
 	// This allows for the use of a custom encryption key.
 	// +optional
 	KeyID string `json:"keyID,omitempty"`
+	// encryption configures server-side encryption of objects written to
+	// the bucket, including customer-managed KMS keys.
+	// +optional
+	Encryption *ImageRegistryEncryption `json:"encryption,omitempty"`
+	// chunkSizeMiB is the size, in mebibytes, of the resumable upload chunks
+	// the registry sends to GCS. Must be a multiple of 0.25 MiB (256KiB), per
+	// the GCS resumable upload API.
+	// Optional, defaults to the driver's default of 16.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=2048
+	// +optional
+	ChunkSizeMiB int32 `json:"chunkSizeMiB,omitempty"`
 }
 
 // ImageRegistryConfigStorageSwift holds the information to configure
@@ -288,14 +400,125 @@ type ImageRegistryConfigStorageSwift struct {
 	// regionName defines Openstack's region in which container exists.
 	// +optional
 	RegionName string `json:"regionName,omitempty"`
+	// encryption configures at-rest encryption of objects written to the
+	// Swift container.
+	// +optional
+	Encryption *SwiftEncryption `json:"encryption,omitempty"`
+	// tempURL configures the swift driver's tempurl middleware so blob GETs
+	// are served as signed, time-limited URLs directly from Swift instead of
+	// being proxied through the registry pods.
+	// +optional
+	TempURL *SwiftTempURL `json:"tempURL,omitempty"`
+}
+
+// SwiftEncryptionMethod defines an enumerable type for the Swift
+// encryption mode.
+// +kubebuilder:validation:Enum=None;Barbican;AES256
+type SwiftEncryptionMethod string
+
+const (
+	// SwiftEncryptionNone means objects are stored unencrypted.
+	SwiftEncryptionNone SwiftEncryptionMethod = "None"
+	// SwiftEncryptionBarbican means objects are encrypted server-side using
+	// an OpenStack Barbican-managed key.
+	SwiftEncryptionBarbican SwiftEncryptionMethod = "Barbican"
+	// SwiftEncryptionAES256 means objects are encrypted client-side by the
+	// registry using an AES256 key before being uploaded to Swift.
+	SwiftEncryptionAES256 SwiftEncryptionMethod = "AES256"
+)
+
+// SwiftEncryption is a union type in kube parlance. Depending on the value
+// of Method, different fields may be used.
+// +union
+type SwiftEncryption struct {
+	// method defines the encryption mode to use.
+	// Empty value means no opinion and the platform chooses a default, which is subject to change over time.
+	// Currently the default is `None`.
+	// +kubebuilder:default="None"
+	// +unionDiscriminator
+	// +optional
+	Method SwiftEncryptionMethod `json:"method"`
+	// secretRef references a Secret holding the Barbican key id, or the
+	// client-side AES256 key, depending on method.
+	// +optional
+	SecretRef corev1.LocalObjectReference `json:"secretRef,omitempty"`
+}
+
+// SwiftTempURLDigest is the hash algorithm used to sign Swift TempURLs.
+// +kubebuilder:validation:Enum=SHA1;SHA256;SHA512
+type SwiftTempURLDigest string
+
+const (
+	// SwiftTempURLDigestSHA1 signs TempURLs with HMAC-SHA1.
+	SwiftTempURLDigestSHA1 SwiftTempURLDigest = "SHA1"
+	// SwiftTempURLDigestSHA256 signs TempURLs with HMAC-SHA256.
+	SwiftTempURLDigestSHA256 SwiftTempURLDigest = "SHA256"
+	// SwiftTempURLDigestSHA512 signs TempURLs with HMAC-SHA512.
+	SwiftTempURLDigestSHA512 SwiftTempURLDigest = "SHA512"
+)
+
+// SwiftTempURL configures signed, time-limited direct-to-Swift blob URLs.
+type SwiftTempURL struct {
+	// keySecret references a Secret in the "openshift-image-registry"
+	// namespace containing the TempURL key configured on the Swift account.
+	KeySecret corev1.LocalObjectReference `json:"keySecret"`
+	// duration is how long a generated TempURL remains valid.
+	// +optional
+	// +kubebuilder:validation:Format=duration
+	Duration metav1.Duration `json:"duration,omitempty"`
+	// digest is the hash algorithm used to sign TempURLs.
+	// +optional
+	// +kubebuilder:default="SHA256"
+	Digest SwiftTempURLDigest `json:"digest,omitempty"`
 }
 
 // ImageRegistryConfigStoragePVC holds Persistent Volume Claims data to
 // be used by the registry.
 type ImageRegistryConfigStoragePVC struct {
 	// claim defines the Persisent Volume Claim's name to be used.
+	// Optional, when empty the operator provisions and manages the claim
+	// itself, honoring storageClassName, size, accessModes and volumeMode
+	// below, subject to Storage.managementState.
 	// +optional
 	Claim string `json:"claim,omitempty"`
+	// storageClassName is the name of the StorageClass to provision the
+	// claim from when claim is empty.
+	// Optional, defaults to the cluster's default StorageClass.
+	// +optional
+	StorageClassName *string `json:"storageClassName,omitempty"`
+	// size is the size of the claim to provision when claim is empty.
+	// Optional, defaults to 100Gi.
+	// +optional
+	Size *resource.Quantity `json:"size,omitempty"`
+	// accessModes are the access modes to request on the claim when claim
+	// is empty.
+	// Optional, defaults to ["ReadWriteOnce"].
+	// +optional
+	// +listType=atomic
+	AccessModes []corev1.PersistentVolumeAccessMode `json:"accessModes,omitempty"`
+	// volumeMode is the volume mode to request on the claim when claim is
+	// empty.
+	// Optional, defaults to Filesystem.
+	// +optional
+	VolumeMode *corev1.PersistentVolumeMode `json:"volumeMode,omitempty"`
+}
+
+// ImageRegistryConfigStorageFilesystem tunes the docker/distribution
+// filesystem driver used when the registry stores its data on a mounted
+// volume (PVC or emptyDir).
+// https://docs.docker.com/registry/storage-drivers/filesystem/
+type ImageRegistryConfigStorageFilesystem struct {
+	// rootDirectory is the absolute path, inside the registry container,
+	// under which blobs and metadata are stored.
+	// Optional, defaults to "/registry".
+	// +optional
+	RootDirectory string `json:"rootDirectory,omitempty"`
+	// maxThreads bounds the number of concurrent filesystem operations the
+	// driver will perform.
+	// Optional, defaults to 100.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxThreads int32 `json:"maxThreads,omitempty"`
 }
 
 // ImageRegistryConfigStorageAzure holds the information to configure
@@ -320,6 +543,18 @@ type ImageRegistryConfigStorageAzure struct {
 	// +kubebuilder:default={"type": "External"}
 	// +optional
 	NetworkAccess *AzureNetworkAccess `json:"networkAccess,omitempty"`
+	// chunkSizeMiB is the size, in mebibytes, of the blocks the registry
+	// uploads to Azure Blob Storage. Larger blocks reduce the number of
+	// requests needed to upload large layers on high-bandwidth links.
+	// Optional, defaults to the driver's default of 4.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	ChunkSizeMiB int32 `json:"chunkSizeMiB,omitempty"`
+	// encryption configures server-side encryption of objects written to
+	// the container, including a customer-managed Key Vault key.
+	// +optional
+	Encryption *ImageRegistryEncryption `json:"encryption,omitempty"`
 }
 
 // AzureNetworkAccess defines the network access properties for the storage account.
@@ -446,6 +681,10 @@ type ImageRegistryConfigStorageIBMCOS struct {
 	// +optional
 	// +kubebuilder:validation:Pattern=`^crn:.+:.+:.+:cloud-object-storage:.+:.+:.+::$`
 	ServiceInstanceCRN string `json:"serviceInstanceCRN,omitempty"`
+	// encryption configures server-side encryption of objects written to
+	// the bucket, including a customer-managed IBM Key Protect key.
+	// +optional
+	Encryption *ImageRegistryEncryption `json:"encryption,omitempty"`
 }
 
 // EndpointAccessibility defines the Alibaba VPC endpoint for storage
@@ -489,6 +728,55 @@ type KMSEncryptionAlibaba struct {
 	KeyID string `json:"keyID"`
 }
 
+// ImageRegistryEncryptionMethod defines an enumerable type for the shared
+// server-side encryption mode, common across storage backends.
+// +kubebuilder:validation:Enum=None;SSE;KMS;DoubleKMS
+type ImageRegistryEncryptionMethod string
+
+const (
+	// ImageRegistryEncryptionNone means objects are stored unencrypted
+	// beyond whatever the provider applies by default.
+	ImageRegistryEncryptionNone ImageRegistryEncryptionMethod = "None"
+	// ImageRegistryEncryptionSSE means objects are encrypted server-side
+	// with a provider-managed key (e.g. S3 SSE, GCS default encryption).
+	ImageRegistryEncryptionSSE ImageRegistryEncryptionMethod = "SSE"
+	// ImageRegistryEncryptionKMS means objects are encrypted server-side
+	// with a customer-managed KMS key referenced by keyRef.
+	ImageRegistryEncryptionKMS ImageRegistryEncryptionMethod = "KMS"
+	// ImageRegistryEncryptionDoubleKMS layers a second, independently
+	// managed KMS key on top of the provider's default encryption.
+	ImageRegistryEncryptionDoubleKMS ImageRegistryEncryptionMethod = "DoubleKMS"
+)
+
+// ImageRegistryEncryption is a shared union type for configuring
+// server-side encryption across the S3, GCS, Azure, IBMCOS, and OSS storage
+// backends (on OSS it is exposed as kmsEncryption, since encryption is
+// already taken by the pre-existing EncryptionAlibaba field). It replaces
+// the ad-hoc, per-provider encryption fields those backends grew
+// independently with one auditable surface that the operator translates to
+// each driver's native flags (SSE-KMS on S3, CMEK on GCS, CPK/Key Vault on
+// Azure, IBM Key Protect on COS, KMS on OSS).
+// +union
+type ImageRegistryEncryption struct {
+	// method defines the encryption mode to use.
+	// Empty value means no opinion and the platform chooses a default, which is subject to change over time.
+	// Currently the default is `None`.
+	// +kubebuilder:default="None"
+	// +unionDiscriminator
+	// +optional
+	Method ImageRegistryEncryptionMethod `json:"method"`
+	// keyRef identifies the customer-managed key to use when method is KMS
+	// or DoubleKMS. Its format is provider-specific: a key ARN/URI for
+	// cloud-native KMS services, or the name of a Secret holding a wrapped
+	// key.
+	// +optional
+	KeyRef string `json:"keyRef,omitempty"`
+	// bucketKeyEnabled enables a provider's bucket-level key optimization
+	// (e.g. S3 Bucket Keys) to reduce KMS request volume, where supported.
+	// +optional
+	BucketKeyEnabled *bool `json:"bucketKeyEnabled,omitempty"`
+}
+
 // ImageRegistryConfigStorageAlibabaOSS holds Alibaba Cloud OSS configuration.
 // Configures the registry to use Alibaba Cloud Object Storage Service for backend storage.
 // More about oss, you can look at the [official documentation](https://www.alibabacloud.com/help/product/31815.htm)
@@ -516,13 +804,197 @@ type ImageRegistryConfigStorageAlibabaOSS struct {
 	// +optional
 	EndpointAccessibility EndpointAccessibility `json:"endpointAccessibility,omitempty"`
 	// Encryption specifies whether you would like your data encrypted on the server side.
+	// Deprecated: use kmsEncryption instead.
 	// More details, you can look cat the [official documentation](https://www.alibabacloud.com/help/doc-detail/117914.htm)
 	// +optional
 	Encryption *EncryptionAlibaba `json:"encryption,omitempty"`
+	// KMSEncryption configures server-side encryption of objects written to
+	// the bucket, including a customer-managed KMS key. It supersedes
+	// encryption with a shared encryption surface common across storage
+	// backends.
+	// +optional
+	KMSEncryption *ImageRegistryEncryption `json:"kmsEncryption,omitempty"`
+	// ChunkSizeMiB is the size, in mebibytes, of the multipart upload chunks
+	// the registry sends to OSS. Larger chunks reduce the number of requests
+	// needed to upload large layers on high-bandwidth links.
+	// Empty value means no opinion and the platform chooses a default, which is subject to change over time.
+	// Currently the default is 5.
+	// +kubebuilder:validation:Minimum=5
+	// +kubebuilder:validation:Maximum=5120
+	// +optional
+	ChunkSizeMiB int32 `json:"chunkSizeMiB,omitempty"`
+}
+
+// RedirectVerb is an HTTP verb that blob-serving redirects can be
+// controlled for.
+// +kubebuilder:validation:Enum=Get;Head
+type RedirectVerb string
+
+const (
+	// RedirectVerbGet covers blob GET requests.
+	RedirectVerbGet RedirectVerb = "Get"
+	// RedirectVerbHead covers blob HEAD requests.
+	RedirectVerbHead RedirectVerb = "Head"
+)
+
+// RedirectException overrides the redirect policy for a single storage
+// driver and, optionally, a subset of repositories within it.
+type RedirectException struct {
+	// driver is the storage driver this exception applies to.
+	// +kubebuilder:validation:Enum=s3;gcs;azure;swift;oss;objectStore;r2;b2
+	Driver string `json:"driver"`
+	// repositoryPattern is a regular expression matched against the
+	// repository name. When empty, the exception applies to every
+	// repository served by driver.
+	// +optional
+	RepositoryPattern string `json:"repositoryPattern,omitempty"`
+	// disable indicates whether redirects should be disabled for
+	// repositories matching this exception.
+	Disable bool `json:"disable"`
+	// disableFor lists the HTTP verbs redirects are disabled for. Empty
+	// means disable applies to every verb.
+	// +optional
+	// +listType=atomic
+	DisableFor []RedirectVerb `json:"disableFor,omitempty"`
+}
+
+// ImageRegistryConfigRedirect configures, per HTTP verb and per storage
+// driver, whether the registry redirects blob-serving requests to the
+// storage backend instead of streaming them itself.
+type ImageRegistryConfigRedirect struct {
+	// disable indicates whether to route blob requests through the registry
+	// instead of redirecting them to the storage's general URL.
+	// +optional
+	Disable bool `json:"disable,omitempty"`
+	// disableFor lists the HTTP verbs that should not be redirected. Empty
+	// means disable applies to every verb. Has no effect unless disable is
+	// true.
+	// +optional
+	// +listType=atomic
+	DisableFor []RedirectVerb `json:"disableFor,omitempty"`
+	// exceptions overrides the redirect behavior above for specific storage
+	// drivers and, optionally, a subset of their repositories.
+	// +optional
+	// +listType=atomic
+	Exceptions []RedirectException `json:"exceptions,omitempty"`
+}
+
+// CredentialsRotationSpec describes how often an in-cluster storage
+// backend's credentials should be rotated and how the registry should pick
+// up the new values.
+type CredentialsRotationSpec struct {
+	// interval is how often the credentials should be rotated.
+	// +optional
+	// +kubebuilder:validation:Format=duration
+	Interval metav1.Duration `json:"interval,omitempty"`
+	// watchSecret indicates whether the operator should watch
+	// credentialsSecret for changes and hot-reload the storage driver's
+	// credentials without restarting registry pods.
+	// +optional
+	WatchSecret bool `json:"watchSecret,omitempty"`
+}
+
+// ImageRegistryConfigStorageObjectStore holds the information to configure
+// the registry to use an in-cluster, S3-compatible object store (such as
+// MinIO, Ceph RGW, or NooBaa) for backend storage.
+type ImageRegistryConfigStorageObjectStore struct {
+	// service is a reference to the in-cluster Service fronting the object
+	// store, used to derive the endpoint.
+	Service corev1.LocalObjectReference `json:"service"`
+	// bucket is the bucket name in which you want to store the registry's
+	// data.
+	Bucket string `json:"bucket"`
+	// region is the region to present to the S3-compatible service.
+	// Optional, most in-cluster gateways ignore this value.
+	// +optional
+	Region string `json:"region,omitempty"`
+	// forcePathStyle indicates whether to use path-style addressing
+	// (https://host/bucket/key) instead of virtual-hosted style
+	// (https://bucket.host/key), which most in-cluster S3-compatible
+	// gateways require.
+	// +optional
+	ForcePathStyle bool `json:"forcePathStyle,omitempty"`
+	// credentialsSecret references a Secret in the same namespace as the
+	// registry operand, containing accessKey and secretKey data entries.
+	CredentialsSecret corev1.LocalObjectReference `json:"credentialsSecret"`
+	// credentialsRotation describes the rotation schedule and hot-reload
+	// behavior for credentialsSecret.
+	// +optional
+	CredentialsRotation *CredentialsRotationSpec `json:"credentialsRotation,omitempty"`
+	// trustedCA is a reference to a config map containing a CA bundle. The
+	// image registry and its operator use certificates from this bundle to
+	// verify the endpoint's server certificate, which is commonly
+	// self-signed for in-cluster deployments.
+	//
+	// The namespace for the config map referenced by trustedCA is
+	// "openshift-config". The key for the bundle in the config map is
+	// "ca-bundle.crt".
+	// +optional
+	TrustedCA ConfigMapReference `json:"trustedCA"`
+	// insecure allows the operator and registry to connect to the endpoint
+	// over plain HTTP, or to skip verifying its server certificate over
+	// HTTPS. Not recommended outside of development or trusted
+	// cluster-internal networks.
+	// +optional
+	Insecure bool `json:"insecure,omitempty"`
+}
+
+// ConfigMapReference references a config map with a CA certificate bundle
+// in the "openshift-config" namespace. The key for the bundle in the
+// config map is "ca-bundle.crt". It is equivalent to S3TrustedCASource, but
+// named generically for use by non-AWS storage backends.
+type ConfigMapReference struct {
+	// name is the metadata.name of the referenced config map.
+	// This field must adhere to standard config map naming restrictions.
+	// The name must consist solely of alphanumeric characters, hyphens (-)
+	// and periods (.). It has a maximum length of 253 characters.
+	// If this field is not specified or is empty string, the default trust
+	// bundle will be used.
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^$|^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`
+	// +optional
+	Name string `json:"name"`
+}
+
+// ImageRegistryConfigStorageR2 holds the information to configure the
+// registry to use Cloudflare R2 for backend storage.
+type ImageRegistryConfigStorageR2 struct {
+	// accountID is the Cloudflare account ID that owns bucket. The R2
+	// endpoint is derived from it (https://<accountID>.r2.cloudflarestorage.com).
+	AccountID string `json:"accountID"`
+	// bucket is the bucket name in which you want to store the registry's
+	// data.
+	Bucket string `json:"bucket"`
+	// credentialsSecret references a Secret in the same namespace as the
+	// registry operand, containing accessKeyID and secretAccessKey data
+	// entries for an R2 API token.
+	CredentialsSecret corev1.LocalObjectReference `json:"credentialsSecret"`
+}
+
+// ImageRegistryConfigStorageB2 holds the information to configure the
+// registry to use Backblaze B2 for backend storage.
+type ImageRegistryConfigStorageB2 struct {
+	// bucket is the bucket name in which you want to store the registry's
+	// data.
+	Bucket string `json:"bucket"`
+	// region is the B2 region in which bucket exists, used to derive the
+	// native b2_ API endpoint.
+	Region string `json:"region"`
+	// credentialsSecret references a Secret in the same namespace as the
+	// registry operand, containing keyID and applicationKey data entries
+	// for a B2 application key.
+	CredentialsSecret corev1.LocalObjectReference `json:"credentialsSecret"`
 }
 
 // ImageRegistryConfigStorage describes how the storage should be configured
 // for the image registry.
+//
+// At most one storage backend (emptyDir, s3, gcs, swift, pvc, azure,
+// ibmcos, oss, objectStore, r2, or b2) is expected to be set; this is
+// enforced for new and updated values only, since CRD validation is not
+// retroactive and existing stored Config objects may already carry more
+// than one backend from before this constraint was introduced.
+// +kubebuilder:validation:XValidation:rule="[has(self.emptyDir),has(self.s3),has(self.gcs),has(self.swift),has(self.pvc),has(self.azure),has(self.ibmcos),has(self.oss),has(self.objectStore),has(self.r2),has(self.b2)].filter(x,x).size()<=1",message="at most one storage backend must be set"
 type ImageRegistryConfigStorage struct {
 	// emptyDir represents ephemeral storage on the pod's host node.
 	// WARNING: this storage cannot be used with more than 1 replica and
@@ -551,12 +1023,99 @@ type ImageRegistryConfigStorage struct {
 	// Oss represents configuration that uses Alibaba Cloud Object Storage Service.
 	// +optional
 	OSS *ImageRegistryConfigStorageAlibabaOSS `json:"oss,omitempty"`
+	// objectStore represents configuration that uses an in-cluster,
+	// S3-compatible object store such as MinIO, Ceph RGW, SeaweedFS, or
+	// NooBaa. The operator never manages the bucket lifecycle for this
+	// backend; treat managementState as Unmanaged.
+	// +optional
+	ObjectStore *ImageRegistryConfigStorageObjectStore `json:"objectStore,omitempty"`
+	// filesystem tunes the filesystem driver used when storing data on a
+	// mounted volume (pvc or emptyDir).
+	// +optional
+	Filesystem *ImageRegistryConfigStorageFilesystem `json:"filesystem,omitempty"`
+	// r2 represents configuration that uses Cloudflare R2.
+	// +optional
+	R2 *ImageRegistryConfigStorageR2 `json:"r2,omitempty"`
+	// b2 represents configuration that uses Backblaze B2.
+	// +optional
+	B2 *ImageRegistryConfigStorageB2 `json:"b2,omitempty"`
 	// managementState indicates if the operator manages the underlying
 	// storage unit. If Managed the operator will remove the storage when
 	// this operator gets Removed.
 	// +optional
 	// +kubebuilder:validation:Pattern=`^(Managed|Unmanaged)$`
 	ManagementState string `json:"managementState,omitempty"`
+	// backends is a list of named storage backends that routes can pin
+	// repositories to. Each entry must set exactly one backend field, using
+	// the same backend fields as this struct.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	Backends []NamedImageRegistryConfigStorage `json:"backends,omitempty"`
+	// routes matches image repositories to a named backend from backends.
+	// Repositories that match no route use the backend configured directly
+	// on this struct as the default.
+	// +optional
+	// +listType=atomic
+	Routes []ImageRegistryConfigStorageRoute `json:"routes,omitempty"`
+}
+
+// NamedImageRegistryConfigStorage is a single storage backend with a name
+// routes can refer to. It carries only the backend-selector fields of
+// ImageRegistryConfigStorage (not filesystem/backends/routes/managementState), since
+// nesting the full struct here would make the type self-referential and
+// unrepresentable as a structural CRD schema.
+// +kubebuilder:validation:XValidation:rule="[has(self.emptyDir),has(self.s3),has(self.gcs),has(self.swift),has(self.pvc),has(self.azure),has(self.ibmcos),has(self.oss),has(self.objectStore),has(self.r2),has(self.b2)].filter(x,x).size()==1",message="exactly one storage backend must be set"
+type NamedImageRegistryConfigStorage struct {
+	// name identifies this backend for use in routes[].backendName. Must be
+	// unique within backends.
+	Name string `json:"name"`
+	// emptyDir represents ephemeral storage on the pod's host node.
+	// +optional
+	EmptyDir *ImageRegistryConfigStorageEmptyDir `json:"emptyDir,omitempty"`
+	// s3 represents configuration that uses Amazon Simple Storage Service.
+	// +optional
+	S3 *ImageRegistryConfigStorageS3 `json:"s3,omitempty"`
+	// gcs represents configuration that uses Google Cloud Storage.
+	// +optional
+	GCS *ImageRegistryConfigStorageGCS `json:"gcs,omitempty"`
+	// swift represents configuration that uses OpenStack Object Storage.
+	// +optional
+	Swift *ImageRegistryConfigStorageSwift `json:"swift,omitempty"`
+	// pvc represents configuration that uses a PersistentVolumeClaim.
+	// +optional
+	PVC *ImageRegistryConfigStoragePVC `json:"pvc,omitempty"`
+	// azure represents configuration that uses Azure Blob Storage.
+	// +optional
+	Azure *ImageRegistryConfigStorageAzure `json:"azure,omitempty"`
+	// ibmcos represents configuration that uses IBM Cloud Object Storage.
+	// +optional
+	IBMCOS *ImageRegistryConfigStorageIBMCOS `json:"ibmcos,omitempty"`
+	// oss represents configuration that uses Alibaba Cloud Object Storage Service.
+	// +optional
+	OSS *ImageRegistryConfigStorageAlibabaOSS `json:"oss,omitempty"`
+	// objectStore represents configuration that uses an in-cluster,
+	// S3-compatible object store such as MinIO, Ceph RGW, SeaweedFS, or
+	// NooBaa.
+	// +optional
+	ObjectStore *ImageRegistryConfigStorageObjectStore `json:"objectStore,omitempty"`
+	// r2 represents configuration that uses Cloudflare R2.
+	// +optional
+	R2 *ImageRegistryConfigStorageR2 `json:"r2,omitempty"`
+	// b2 represents configuration that uses Backblaze B2.
+	// +optional
+	B2 *ImageRegistryConfigStorageB2 `json:"b2,omitempty"`
+}
+
+// ImageRegistryConfigStorageRoute matches image repositories by name and
+// pins them to a named storage backend.
+type ImageRegistryConfigStorageRoute struct {
+	// repositoryPattern is a glob or regular expression matched against the
+	// repository name, e.g. "tenant-*/prod/*".
+	RepositoryPattern string `json:"repositoryPattern"`
+	// backendName is the name of the entry in backends this route directs
+	// matching repositories to.
+	BackendName string `json:"backendName"`
 }
 
 // ImageRegistryConfigRequests defines registry limits on requests read and write.
@@ -584,6 +1143,52 @@ type ImageRegistryConfigRequestsLimits struct {
 	// +optional
 	// +kubebuilder:validation:Format=duration
 	MaxWaitInQueue metav1.Duration `json:"maxWaitInQueue,omitempty"`
+	// classes partitions requests into weighted, fair-share subqueues.
+	// Requests are classified by the first matching class; unmatched
+	// requests fall back to the global maxRunning/maxInQueue behavior
+	// above. maxRunning and maxInQueue above remain the aggregate ceiling
+	// across all classes.
+	// +optional
+	// +listType=atomic
+	Classes []ImageRegistryConfigRequestClass `json:"classes,omitempty"`
+}
+
+// ImageRegistryConfigRequestMatch selects requests for an
+// ImageRegistryConfigRequestClass.
+type ImageRegistryConfigRequestMatch struct {
+	// user matches requests authenticated as this username.
+	// +optional
+	User string `json:"user,omitempty"`
+	// serviceAccount matches requests authenticated as this
+	// "namespace:name" service account.
+	// +optional
+	ServiceAccount string `json:"serviceAccount,omitempty"`
+	// namespace matches requests originating from this namespace.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	// clientCIDR matches requests whose client address falls within this
+	// CIDR.
+	// +optional
+	ClientCIDR string `json:"clientCIDR,omitempty"`
+}
+
+// ImageRegistryConfigRequestClass defines a weighted-fair-queueing class
+// of requests, modeled on the API Priority and Fairness scheme.
+type ImageRegistryConfigRequestClass struct {
+	// name identifies this class in status and logs.
+	Name string `json:"name"`
+	// match selects the requests that belong to this class.
+	Match ImageRegistryConfigRequestMatch `json:"match"`
+	// weight is this class's share of the queue relative to other classes'
+	// weights.
+	// +kubebuilder:validation:Minimum=1
+	Weight int `json:"weight"`
+	// maxRunning sets the maximum in-flight requests for this class.
+	// +optional
+	MaxRunning int `json:"maxRunning,omitempty"`
+	// maxInQueue sets the maximum queued requests for this class.
+	// +optional
+	MaxInQueue int `json:"maxInQueue,omitempty"`
 }
 
 // ImageRegistryConfigRoute holds information on external route access to image
@@ -598,4 +1203,56 @@ type ImageRegistryConfigRoute struct {
 	// by the route.
 	// +optional
 	SecretName string `json:"secretName,omitempty"`
+	// clientCASecret points to a secret in the "openshift-config"
+	// namespace containing a CA bundle used to verify client certificates,
+	// required when clientCertPolicy is not None.
+	// +optional
+	ClientCASecret string `json:"clientCASecret,omitempty"`
+	// clientCertPolicy controls whether the route requires, accepts, or
+	// ignores client certificates.
+	// +kubebuilder:validation:Enum=Required;Optional;None
+	// +optional
+	ClientCertPolicy ClientCertPolicy `json:"clientCertPolicy,omitempty"`
+	// additionalHostnames lets a single route answer on extra SNI
+	// hostnames, each served with its own secretName, instead of requiring
+	// a separate ImageRegistryConfigRoute per hostname.
+	// +optional
+	// +listType=map
+	// +listMapKey=hostname
+	AdditionalHostnames []ImageRegistryConfigRouteSNI `json:"additionalHostnames,omitempty"`
+	// tlsMinVersion is the minimum TLS version this route accepts,
+	// overriding the cluster's ingress default.
+	// +optional
+	// +kubebuilder:validation:Enum=VersionTLS10;VersionTLS11;VersionTLS12;VersionTLS13
+	TLSMinVersion string `json:"tlsMinVersion,omitempty"`
+	// cipherSuites overrides the cluster's ingress default cipher suites for
+	// this route.
+	// +optional
+	// +listType=atomic
+	CipherSuites []string `json:"cipherSuites,omitempty"`
+}
+
+// ClientCertPolicy controls whether a route requires client certificates
+// for mutual TLS.
+type ClientCertPolicy string
+
+const (
+	// ClientCertPolicyRequired rejects connections that do not present a
+	// certificate signed by clientCASecret.
+	ClientCertPolicyRequired ClientCertPolicy = "Required"
+	// ClientCertPolicyOptional verifies a presented certificate against
+	// clientCASecret but does not require one.
+	ClientCertPolicyOptional ClientCertPolicy = "Optional"
+	// ClientCertPolicyNone does not request or verify client certificates.
+	ClientCertPolicyNone ClientCertPolicy = "None"
+)
+
+// ImageRegistryConfigRouteSNI binds an additional SNI hostname to a route,
+// served with its own certificate.
+type ImageRegistryConfigRouteSNI struct {
+	// hostname is the additional SNI hostname to serve.
+	Hostname string `json:"hostname"`
+	// secretName points to a secret containing the certificate to present
+	// for hostname.
+	SecretName string `json:"secretName"`
 }